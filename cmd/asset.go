@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siddharthkm-06/go-cloud-scanner/internal/scanner"
+)
+
+var assetCmd = &cobra.Command{
+	Use:   "asset",
+	Short: "Manage the user-supplied asset inventory",
+}
+
+var assetInventoryPath string
+
+var assetCreateFile string
+
+var assetCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Ingest an asset file (JSON or YAML) into the inventory",
+	RunE:  runAssetCreate,
+}
+
+var assetListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Print every asset in the inventory",
+	RunE:  runAssetList,
+}
+
+func init() {
+	assetCmd.PersistentFlags().StringVar(&assetInventoryPath, "inventory", scanner.DefaultInventoryPath, "path to the asset inventory file")
+
+	assetCreateCmd.Flags().StringVarP(&assetCreateFile, "file", "f", "", "path to an asset file describing {id, type, name, isPublic, tags}")
+	assetCreateCmd.MarkFlagRequired("file")
+
+	assetCmd.AddCommand(assetCreateCmd)
+	assetCmd.AddCommand(assetListCmd)
+}
+
+func runAssetCreate(cmd *cobra.Command, args []string) error {
+	raw, err := os.ReadFile(assetCreateFile)
+	if err != nil {
+		return fmt.Errorf("reading asset file %q: %w", assetCreateFile, err)
+	}
+
+	asset, err := parseAssetFile(assetCreateFile, raw)
+	if err != nil {
+		return err
+	}
+
+	store := scanner.NewInventoryStore(assetInventoryPath)
+	if err := store.Add(asset); err != nil {
+		return fmt.Errorf("adding asset to inventory: %w", err)
+	}
+
+	fmt.Printf("Added asset %q to %s\n", asset.ID, assetInventoryPath)
+	return nil
+}
+
+// parseAssetFile decodes an asset description as JSON or YAML based on
+// path's extension, defaulting to YAML (a superset of JSON) if ambiguous.
+func parseAssetFile(path string, raw []byte) (scanner.Asset, error) {
+	var asset scanner.Asset
+	var err error
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		err = json.Unmarshal(raw, &asset)
+	default:
+		err = yaml.Unmarshal(raw, &asset)
+	}
+	if err != nil {
+		return scanner.Asset{}, fmt.Errorf("parsing asset file %q: %w", path, err)
+	}
+	return asset, nil
+}
+
+func runAssetList(cmd *cobra.Command, args []string) error {
+	store := scanner.NewInventoryStore(assetInventoryPath)
+	assets, err := store.List()
+	if err != nil {
+		return fmt.Errorf("listing inventory: %w", err)
+	}
+
+	if len(assets) == 0 {
+		fmt.Println("Inventory is empty.")
+		return nil
+	}
+
+	for _, a := range assets {
+		fmt.Printf("%-20s%-18s%-30spublic=%v\n", a.ID, a.Type, a.Name, a.IsPublic)
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package cmd
+
+import "testing"
+
+// TestParseAssetFileYAML guards against yaml.v3's default field-name
+// lowercasing: without explicit yaml tags on scanner.Asset, a file using the
+// documented `isPublic:` key would silently decode to IsPublic=false.
+func TestParseAssetFileYAML(t *testing.T) {
+	raw := []byte(`
+id: gcp-010
+type: STORAGE_BUCKET
+name: example-bucket
+isPublic: true
+tags:
+  - production
+`)
+
+	asset, err := parseAssetFile("asset.yaml", raw)
+	if err != nil {
+		t.Fatalf("parseAssetFile: %v", err)
+	}
+
+	if asset.ID != "gcp-010" || asset.Type != "STORAGE_BUCKET" || asset.Name != "example-bucket" {
+		t.Errorf("asset = %+v, want id/type/name from the YAML file", asset)
+	}
+	if !asset.IsPublic {
+		t.Errorf("asset.IsPublic = false, want true")
+	}
+	if len(asset.Tags) != 1 || asset.Tags[0] != "production" {
+		t.Errorf("asset.Tags = %+v, want [production]", asset.Tags)
+	}
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siddharthkm-06/go-cloud-scanner/internal/scanner"
+)
+
+var policyCmd = &cobra.Command{
+	Use:   "policy",
+	Short: "Work with policy files",
+}
+
+var policyValidateCmd = &cobra.Command{
+	Use:   "validate <dir>",
+	Short: "Parse and type-check every rule file in a policy directory without running a scan",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runPolicyValidate,
+}
+
+func init() {
+	policyCmd.AddCommand(policyValidateCmd)
+}
+
+func runPolicyValidate(cmd *cobra.Command, args []string) error {
+	dir := args[0]
+
+	engine, err := scanner.NewPolicyEngine(dir)
+	if err != nil {
+		return fmt.Errorf("validating policies in %q: %w", dir, err)
+	}
+
+	for _, rule := range engine.Rules() {
+		fmt.Printf("OK  %-12s severity=%s\n", rule.ID(), rule.Severity())
+	}
+	fmt.Printf("%d polic%s valid\n", len(engine.Rules()), pluralY(len(engine.Rules())))
+	return nil
+}
+
+func pluralY(n int) string {
+	if n == 1 {
+		return "y"
+	}
+	return "ies"
+}
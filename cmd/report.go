@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siddharthkm-06/go-cloud-scanner/internal/scanner"
+)
+
+var (
+	reportDBPath     string
+	reportOutput     string
+	reportOutputPath string
+)
+
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Work with persisted scan reports",
+}
+
+var reportShowCmd = &cobra.Command{
+	Use:   "show <report_id>",
+	Short: "Re-render a stored report in any supported --output format",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runReportShow,
+}
+
+func init() {
+	reportCmd.PersistentFlags().StringVar(&reportDBPath, "db", "scanner.db", "path to the scan history SQLite database")
+	reportShowCmd.Flags().StringVar(&reportOutput, "output", "human", "report format: human, json, yaml, xml, sarif, junit")
+	reportShowCmd.Flags().StringVar(&reportOutputPath, "output-path", "", "file to write the report to (defaults per format)")
+
+	reportCmd.AddCommand(reportShowCmd)
+}
+
+func runReportShow(cmd *cobra.Command, args []string) error {
+	reportID := args[0]
+
+	store, err := scanner.OpenScanStore(reportDBPath)
+	if err != nil {
+		return fmt.Errorf("opening scan store: %w", err)
+	}
+	defer store.Close()
+
+	record, err := store.GetScan(reportID)
+	if err != nil {
+		return fmt.Errorf("loading report %q: %w", reportID, err)
+	}
+
+	reporter, err := scanner.ResolveReporter(reportOutput)
+	if err != nil {
+		return err
+	}
+
+	// The engine that produced this report isn't reconstructed from
+	// storage; reporters that need rule metadata (e.g. sarif) degrade
+	// gracefully to a nil engine.
+	return reporter.Report(record.Assets, nil, reportOutputPath)
+}
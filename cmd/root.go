@@ -0,0 +1,22 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+var rootCmd = &cobra.Command{
+	Use:   "scanner",
+	Short: "Automated cloud asset & compliance scanner",
+	Long:  "Welcome to the Automated Cloud Asset & Compliance Scanner!\nInitiating scan for Mercari compliance...",
+}
+
+// Execute runs the scanner CLI, returning any error encountered.
+func Execute() error {
+	return rootCmd.Execute()
+}
+
+func init() {
+	rootCmd.AddCommand(scanCmd)
+	rootCmd.AddCommand(assetCmd)
+	rootCmd.AddCommand(policyCmd)
+	rootCmd.AddCommand(reportCmd)
+	rootCmd.AddCommand(serveCmd)
+}
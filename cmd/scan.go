@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/siddharthkm-06/go-cloud-scanner/internal/scanner"
+)
+
+var (
+	scanPolicyDir          string
+	scanOutput             string
+	scanOutputPath         string
+	scanDBPath             string
+	scanLogDir             string
+	scanWebhookSecret      string
+	scanWebhookMinSeverity string
+	scanScoringPolicyPath  string
+	scanFailOn             string
+	scanMinScore           int
+	scanProviders          []string
+	scanWebhookURLs        []string
+	scanSlackURLs          []string
+)
+
+var scanCmd = &cobra.Command{
+	Use:   "scan",
+	Short: "Discover cloud assets and evaluate them against policy",
+	RunE:  runScan,
+}
+
+func init() {
+	flags := scanCmd.Flags()
+	flags.StringVar(&scanPolicyDir, "policy-dir", "policies", "directory of Rego/YAML policy files to evaluate")
+	flags.StringVar(&scanOutput, "output", "human", "report format: human, json, yaml, xml, sarif, junit")
+	flags.StringVar(&scanOutputPath, "output-path", "", "file to write the report to (defaults per format)")
+	flags.StringVar(&scanDBPath, "db", "scanner.db", "path to the scan history SQLite database")
+	flags.StringVar(&scanLogDir, "log-dir", "./scans", "directory to write per-run execution logs to")
+	flags.StringVar(&scanWebhookSecret, "webhook-secret", "", "HMAC-SHA256 secret used to sign outgoing webhook events")
+	flags.StringVar(&scanWebhookMinSeverity, "webhook-min-severity", "", "minimum violation severity that triggers a webhook (LOW, MEDIUM, HIGH, CRITICAL)")
+	flags.StringVar(&scanScoringPolicyPath, "scoring-policy", "scoring.yaml", "path to a scoring.yaml with per-severity weights and aggregation mode")
+	flags.StringVar(&scanFailOn, "fail-on", "", "exit non-zero if any violation at or above this severity is found (LOW, MEDIUM, HIGH, CRITICAL)")
+	flags.IntVar(&scanMinScore, "min-score", 0, "exit non-zero if any asset's compliance score falls below this value")
+	flags.StringArrayVar(&scanProviders, "provider", nil, "cloud provider to discover assets from (repeatable): mock, aws, azure, gcp, inventory")
+	flags.StringArrayVar(&scanWebhookURLs, "webhook-url", nil, "webhook URL to notify of compliance events (repeatable)")
+	flags.StringArrayVar(&scanSlackURLs, "slack-webhook-url", nil, "Slack incoming webhook URL to notify of compliance events (repeatable)")
+}
+
+func runScan(cmd *cobra.Command, args []string) error {
+	providerNames := scanProviders
+	if len(providerNames) == 0 {
+		providerNames = []string{"mock"}
+	}
+
+	reportID := uuid.NewString()
+	logw, logFile, err := scanner.NewRunLogger(scanLogDir, reportID)
+	if err != nil {
+		return fmt.Errorf("creating run log: %w", err)
+	}
+	defer logFile.Close()
+
+	fmt.Fprintln(logw, "Welcome to the Automated Cloud Asset & Compliance Scanner!")
+	fmt.Fprintln(logw, "Initiating scan for Mercari compliance...")
+	fmt.Fprintln(logw, "-------------------------------------------------------")
+
+	engine, err := scanner.NewPolicyEngine(scanPolicyDir)
+	if err != nil {
+		fmt.Fprintf(logw, "Error loading policies from %q: %v\n", scanPolicyDir, err)
+		os.Exit(scanner.ExitRuntimeError)
+	}
+
+	scoringPolicy, err := scanner.LoadScoringPolicy(scanScoringPolicyPath)
+	if err != nil {
+		fmt.Fprintf(logw, "Error loading scoring policy from %q: %v\n", scanScoringPolicyPath, err)
+		os.Exit(scanner.ExitRuntimeError)
+	}
+
+	providers, err := scanner.ResolveProviders(providerNames)
+	if err != nil {
+		fmt.Fprintf(logw, "Error resolving providers: %v\n", err)
+		os.Exit(scanner.ExitRuntimeError)
+	}
+
+	startedAt := time.Now()
+	assets, err := scanner.DiscoverAssets(context.Background(), providers)
+	if err != nil {
+		fmt.Fprintf(logw, "Error discovering assets: %v\n", err)
+		os.Exit(scanner.ExitRuntimeError)
+	}
+
+	fmt.Fprintf(logw, "Scanning %d assets against %d policies...\n\n", len(assets), len(engine.Rules()))
+
+	notifiers := scanner.BuildNotifiers(scanWebhookURLs, scanWebhookSecret, scanWebhookMinSeverity, scanSlackURLs)
+	notifierPool := scanner.NewNotifierPool(notifiers)
+
+	for i := range assets {
+		scanner.CheckCompliance(&assets[i], engine, scoringPolicy)
+		notifyAssetViolations(notifierPool, reportID, &assets[i])
+	}
+	finishedAt := time.Now()
+
+	fmt.Fprintln(logw, "\n-------------------------------------------------------")
+	fmt.Fprintln(logw, "Scan Complete. Summary:")
+
+	reporter, err := scanner.ResolveReporter(scanOutput)
+	if err != nil {
+		fmt.Fprintf(logw, "Error resolving reporter: %v\n", err)
+		os.Exit(scanner.ExitRuntimeError)
+	}
+
+	if err := reporter.Report(assets, engine, scanOutputPath); err != nil {
+		fmt.Fprintf(logw, "Error generating %s report: %v\n", scanOutput, err)
+		os.Exit(scanner.ExitRuntimeError)
+	}
+
+	store, err := scanner.OpenScanStore(scanDBPath)
+	if err != nil {
+		fmt.Fprintf(logw, "Error opening scan store: %v\n", err)
+		os.Exit(scanner.ExitRuntimeError)
+	}
+	defer store.Close()
+
+	if err := store.SaveScan(reportID, strings.Join(providerNames, ","), startedAt, finishedAt, assets); err != nil {
+		fmt.Fprintf(logw, "Error persisting scan: %v\n", err)
+		os.Exit(scanner.ExitRuntimeError)
+	}
+
+	summary := scanner.ScanSummaryOf(assets)
+	notifierPool.Dispatch(scanner.Event{
+		Event:    scanner.EventScanCompleted,
+		OccurAt:  finishedAt,
+		Operator: "scanner",
+		EventData: scanner.EventData{
+			ReportID: reportID,
+			Summary:  &summary,
+		},
+	})
+	notifierPool.Close()
+
+	fmt.Fprintf(logw, "\nReport ID: %s\n", reportID)
+
+	os.Exit(scanExitCode(assets, scanFailOn, scanMinScore))
+	return nil
+}
+
+// scanExitCode picks the exit code CI should see: a breached --fail-on/
+// --min-score threshold takes priority over the plain "violations found"
+// code, and a clean scan exits 0.
+func scanExitCode(assets []scanner.Asset, failOn string, minScore int) int {
+	if scanner.FailOnThresholdBreached(assets, failOn, minScore) {
+		return scanner.ExitThresholdBreached
+	}
+	if len(scanner.FailedAssets(assets)) > 0 {
+		return scanner.ExitViolationsFound
+	}
+	return scanner.ExitClean
+}
+
+// notifyAssetViolations dispatches an asset.violation.{critical,high} event
+// for every CRITICAL or HIGH violation found on the asset.
+func notifyAssetViolations(pool *scanner.NotifierPool, reportID string, a *scanner.Asset) {
+	for _, v := range a.Violations {
+		var eventType scanner.EventType
+		switch v.Severity {
+		case "CRITICAL":
+			eventType = scanner.EventAssetViolationCrit
+		case "HIGH":
+			eventType = scanner.EventAssetViolationHigh
+		default:
+			continue
+		}
+		pool.Dispatch(scanner.Event{
+			Event:    eventType,
+			OccurAt:  time.Now(),
+			Operator: "scanner",
+			EventData: scanner.EventData{
+				ReportID:   reportID,
+				Asset:      a,
+				Violations: []scanner.Violation{v},
+			},
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siddharthkm-06/go-cloud-scanner/internal/scanner"
+)
+
+var (
+	serveAddr   string
+	serveDBPath string
+	serveLogDir string
+)
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve persisted scan history over HTTP",
+	RunE:  runServe,
+}
+
+func init() {
+	flags := serveCmd.Flags()
+	flags.StringVar(&serveAddr, "addr", ":8080", "address to serve the scan history API on")
+	flags.StringVar(&serveDBPath, "db", "scanner.db", "path to the scan history SQLite database")
+	flags.StringVar(&serveLogDir, "log-dir", "./scans", "directory per-run execution logs are stored in")
+}
+
+func runServe(cmd *cobra.Command, args []string) error {
+	store, err := scanner.OpenScanStore(serveDBPath)
+	if err != nil {
+		return fmt.Errorf("opening scan store: %w", err)
+	}
+	defer store.Close()
+
+	server := scanner.NewScanServer(store, serveLogDir)
+	if err := server.Serve(serveAddr); err != nil {
+		fmt.Printf("Server error: %v\n", err)
+		os.Exit(scanner.ExitRuntimeError)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package scanner
+
+// Asset represents a generic cloud resource we want to scan.
+type Asset struct {
+	ID              string      `yaml:"id"`
+	Type            string      `yaml:"type"` // e.g., "VM_INSTANCE", "STORAGE_BUCKET"
+	Name            string      `yaml:"name"`
+	IsPublic        bool        `yaml:"isPublic"`
+	Tags            []string    `yaml:"tags"`
+	ComplianceScore int         `yaml:"complianceScore"`
+	Violations      []Violation `yaml:"violations"` // NEW: List of specific issues found
+}
+
+// Violation holds details about a specific compliance failure.
+type Violation struct {
+	RuleID      string `yaml:"ruleID"`
+	Description string `yaml:"description"`
+	Severity    string `yaml:"severity"`
+}
+
+// GenerateMockAssets simulates data returned from a cloud asset inventory API.
+func GenerateMockAssets() []Asset {
+	return []Asset{
+		{
+			ID:              "gcp-001",
+			Type:            "STORAGE_BUCKET",
+			Name:            "mercad-prod-user-photos",
+			IsPublic:        true, // CRITICAL: Publicly exposed bucket
+			Tags:            []string{"production", "user_data"},
+			ComplianceScore: 0,
+		},
+		{
+			ID:              "gcp-002",
+			Type:            "VM_INSTANCE",
+			Name:            "mercad-dev-worker-01",
+			IsPublic:        false,
+			Tags:            []string{"development", "no_pii"},
+			ComplianceScore: 0,
+		},
+		{
+			ID:              "gcp-003",
+			Type:            "STORAGE_BUCKET",
+			Name:            "mercad-logs-archive",
+			IsPublic:        false,
+			Tags:            []string{"logs", "archived"},
+			ComplianceScore: 0,
+		},
+	}
+}
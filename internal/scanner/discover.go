@@ -0,0 +1,59 @@
+package scanner
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// maxConcurrentProviders bounds how many providers run their discovery at
+// once, so a long --provider list doesn't open unbounded connections to
+// every cloud at the same time.
+const maxConcurrentProviders = 4
+
+// DiscoverAssets runs Discover on every provider concurrently, bounded by
+// maxConcurrentProviders, and concatenates the results. The first error
+// encountered is returned after all in-flight providers finish.
+func DiscoverAssets(ctx context.Context, providers []AssetProvider) ([]Asset, error) {
+	type result struct {
+		provider string
+		assets   []Asset
+		err      error
+	}
+
+	results := make(chan result, len(providers))
+	sem := make(chan struct{}, maxConcurrentProviders)
+	var wg sync.WaitGroup
+
+	for _, provider := range providers {
+		wg.Add(1)
+		go func(p AssetProvider) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			assets, err := p.Discover(ctx)
+			results <- result{provider: p.Name(), assets: assets, err: err}
+		}(provider)
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	var (
+		all      []Asset
+		firstErr error
+	)
+	for r := range results {
+		if r.err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("provider %q: %w", r.provider, r.err)
+			}
+			continue
+		}
+		all = append(all, r.assets...)
+	}
+	return all, firstErr
+}
@@ -0,0 +1,78 @@
+package scanner
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// DefaultInventoryPath is where `asset create`/`asset list` and the
+// --provider=inventory provider read and write by default.
+const DefaultInventoryPath = "inventory.json"
+
+// InventoryStore persists user-supplied assets (added via `asset create`) as
+// newline-delimited JSON, so they can be discovered again on later scans
+// via --provider=inventory.
+type InventoryStore struct {
+	path string
+}
+
+// NewInventoryStore returns a store backed by the file at path. The file is
+// created on first Add if it doesn't already exist.
+func NewInventoryStore(path string) *InventoryStore {
+	return &InventoryStore{path: path}
+}
+
+// Add appends asset to the inventory file.
+func (s *InventoryStore) Add(a Asset) error {
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("inventory: opening %q: %w", s.path, err)
+	}
+	defer f.Close()
+
+	data, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(f, string(data))
+	return err
+}
+
+// List returns every asset recorded in the inventory, in the order they
+// were added.
+func (s *InventoryStore) List() ([]Asset, error) {
+	f, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("inventory: opening %q: %w", s.path, err)
+	}
+	defer f.Close()
+
+	var assets []Asset
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var a Asset
+		if err := json.Unmarshal(scanner.Bytes(), &a); err != nil {
+			return nil, fmt.Errorf("inventory: parsing entry: %w", err)
+		}
+		assets = append(assets, a)
+	}
+	return assets, scanner.Err()
+}
+
+// inventoryProvider discovers assets previously ingested with
+// `scanner asset create`.
+type inventoryProvider struct {
+	store *InventoryStore
+}
+
+func (p *inventoryProvider) Name() string { return "inventory" }
+
+func (p *inventoryProvider) Discover(ctx context.Context) ([]Asset, error) {
+	return p.store.List()
+}
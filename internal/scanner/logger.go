@@ -0,0 +1,27 @@
+package scanner
+
+import (
+	"io"
+	"os"
+)
+
+// NewRunLogger returns a logger that tees writes to both stderr and a
+// per-run log file under dir/{reportID}.log, so a completed run's log can
+// be replayed later through the /scans/{report_id}/log endpoint.
+func NewRunLogger(dir, reportID string) (io.Writer, *os.File, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, nil, err
+	}
+
+	path := LogPath(dir, reportID)
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return io.MultiWriter(os.Stderr, f), f, nil
+}
+
+func LogPath(dir, reportID string) string {
+	return dir + "/" + reportID + ".log"
+}
@@ -0,0 +1,68 @@
+package scanner
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// EventType identifies the kind of scan event a Notifier can fire.
+type EventType string
+
+const (
+	EventScanCompleted      EventType = "scan.completed"
+	EventAssetViolationCrit EventType = "asset.violation.critical"
+	EventAssetViolationHigh EventType = "asset.violation.high"
+)
+
+// Event is the stable JSON payload delivered to every notifier, modeled on
+// Harbor's scan webhook events.
+type Event struct {
+	Event     EventType `json:"event"`
+	OccurAt   time.Time `json:"occur_at"`
+	Operator  string    `json:"operator"`
+	EventData EventData `json:"event_data"`
+}
+
+// EventData carries the event's details. Asset and Violations are omitted
+// for scan.completed, where ReportID and Summary are the payload.
+type EventData struct {
+	ReportID   string       `json:"report_id"`
+	Asset      *Asset       `json:"asset,omitempty"`
+	Violations []Violation  `json:"violations,omitempty"`
+	Summary    *ScanSummary `json:"summary,omitempty"`
+}
+
+// Notifier delivers Events to some external system (a webhook URL, Slack,
+// ...). Implementations must not block Dispatch's caller — see
+// NotifierPool, which runs them on a worker pool with retries.
+type Notifier interface {
+	Name() string
+	Notify(ctx context.Context, event Event) error
+}
+
+// minSeverityRank orders severities so a notifier's --webhook-min-severity
+// filter can be expressed as a simple comparison.
+var minSeverityRank = map[string]int{
+	"LOW":      0,
+	"MEDIUM":   1,
+	"HIGH":     2,
+	"CRITICAL": 3,
+}
+
+// eventSeverity returns the severity an event represents, for filtering.
+// scan.completed has no single severity and always passes the filter.
+func eventSeverity(e Event) (string, bool) {
+	switch e.Event {
+	case EventAssetViolationCrit:
+		return "CRITICAL", true
+	case EventAssetViolationHigh:
+		return "HIGH", true
+	default:
+		return "", false
+	}
+}
+
+func marshalEvent(e Event) ([]byte, error) {
+	return json.Marshal(e)
+}
@@ -0,0 +1,14 @@
+package scanner
+
+// BuildNotifiers turns the --webhook-url / --slack-webhook-url flag values
+// into Notifier instances sharing the same secret and severity filter.
+func BuildNotifiers(webhookURLs []string, webhookSecret, minSeverity string, slackURLs []string) []Notifier {
+	notifiers := make([]Notifier, 0, len(webhookURLs)+len(slackURLs))
+	for _, url := range webhookURLs {
+		notifiers = append(notifiers, newWebhookNotifier(url, webhookSecret, minSeverity))
+	}
+	for _, url := range slackURLs {
+		notifiers = append(notifiers, newSlackNotifier(url, minSeverity))
+	}
+	return notifiers
+}
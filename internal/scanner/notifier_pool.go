@@ -0,0 +1,82 @@
+package scanner
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+)
+
+const (
+	notifierQueueSize  = 256
+	notifierWorkers    = 4
+	notifierMaxRetries = 3
+	notifierBaseDelay  = 500 * time.Millisecond
+)
+
+// NotifierPool dispatches Events to every registered Notifier through a
+// buffered channel and a small worker pool, retrying each delivery with
+// exponential backoff.
+type NotifierPool struct {
+	notifiers []Notifier
+	events    chan Event
+	done      chan struct{}
+}
+
+// NewNotifierPool starts notifierWorkers background workers delivering to
+// every notifier in notifiers. Call Close to drain and stop them.
+func NewNotifierPool(notifiers []Notifier) *NotifierPool {
+	pool := &NotifierPool{
+		notifiers: notifiers,
+		events:    make(chan Event, notifierQueueSize),
+		done:      make(chan struct{}),
+	}
+	for i := 0; i < notifierWorkers; i++ {
+		go pool.worker()
+	}
+	return pool
+}
+
+// Dispatch enqueues event for delivery and returns without waiting for it to
+// be delivered. It blocks only while the queue already holds
+// notifierQueueSize events.
+func (p *NotifierPool) Dispatch(event Event) {
+	p.events <- event
+}
+
+// Close stops accepting new events and waits for the queue to drain.
+func (p *NotifierPool) Close() {
+	close(p.events)
+	for i := 0; i < notifierWorkers; i++ {
+		<-p.done
+	}
+}
+
+func (p *NotifierPool) worker() {
+	for event := range p.events {
+		for _, n := range p.notifiers {
+			deliverWithRetry(n, event)
+		}
+	}
+	p.done <- struct{}{}
+}
+
+// deliverWithRetry calls n.Notify up to notifierMaxRetries times with
+// exponential backoff, logging (but not propagating) a final failure —
+// a webhook outage should never take down the scan itself.
+func deliverWithRetry(n Notifier, event Event) {
+	delay := notifierBaseDelay
+	var lastErr error
+	for attempt := 1; attempt <= notifierMaxRetries; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		err := n.Notify(ctx, event)
+		cancel()
+		if err == nil {
+			return
+		}
+		lastErr = err
+		time.Sleep(delay)
+		delay *= 2
+	}
+	fmt.Fprintf(os.Stderr, "notifier %s: giving up after %d attempts: %v\n", n.Name(), notifierMaxRetries, lastErr)
+}
@@ -0,0 +1,81 @@
+package scanner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// slackNotifier posts a Block Kit message to a Slack incoming webhook URL
+// whenever an event passes minSeverity.
+type slackNotifier struct {
+	url         string
+	minSeverity string
+	client      *http.Client
+}
+
+func newSlackNotifier(url, minSeverity string) *slackNotifier {
+	return &slackNotifier{
+		url:         url,
+		minSeverity: minSeverity,
+		client:      &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (s *slackNotifier) Name() string { return "slack:" + s.url }
+
+func (s *slackNotifier) Notify(ctx context.Context, event Event) error {
+	if !passesSeverityFilter(event, s.minSeverity) {
+		return nil
+	}
+
+	body, err := json.Marshal(slackBlockKitMessage(event))
+	if err != nil {
+		return fmt.Errorf("marshalling slack message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("slack webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// slackBlockKitMessage renders an Event as a minimal Block Kit message: a
+// single mrkdwn section naming the event in bold, followed by the report ID,
+// the asset that triggered it (when present) and one line per violation.
+func slackBlockKitMessage(event Event) map[string]interface{} {
+	text := fmt.Sprintf("*%s* — report `%s`", event.Event, event.EventData.ReportID)
+	if event.EventData.Asset != nil {
+		text += fmt.Sprintf("\nAsset `%s` (%s)", event.EventData.Asset.ID, event.EventData.Asset.Type)
+	}
+	for _, v := range event.EventData.Violations {
+		text += fmt.Sprintf("\n• [%s] %s: %s", v.Severity, v.RuleID, v.Description)
+	}
+
+	return map[string]interface{}{
+		"blocks": []map[string]interface{}{
+			{
+				"type": "section",
+				"text": map[string]string{
+					"type": "mrkdwn",
+					"text": text,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,83 @@
+package scanner
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// webhookNotifier POSTs the event JSON to url, signing the body with
+// secret (if set) and attaching it as X-Scanner-Signature.
+type webhookNotifier struct {
+	url         string
+	secret      string
+	minSeverity string
+	client      *http.Client
+}
+
+func newWebhookNotifier(url, secret, minSeverity string) *webhookNotifier {
+	return &webhookNotifier{
+		url:         url,
+		secret:      secret,
+		minSeverity: minSeverity,
+		client:      &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (w *webhookNotifier) Name() string { return "webhook:" + w.url }
+
+func (w *webhookNotifier) Notify(ctx context.Context, event Event) error {
+	if !passesSeverityFilter(event, w.minSeverity) {
+		return nil
+	}
+
+	body, err := marshalEvent(event)
+	if err != nil {
+		return fmt.Errorf("marshalling event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.secret != "" {
+		req.Header.Set("X-Scanner-Signature", signHMACSHA256(w.secret, body))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s returned status %d", w.url, resp.StatusCode)
+	}
+	return nil
+}
+
+func signHMACSHA256(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// passesSeverityFilter reports whether event meets minSeverity, per
+// --webhook-min-severity. scan.completed always passes since it isn't
+// severity-scoped.
+func passesSeverityFilter(event Event, minSeverity string) bool {
+	if minSeverity == "" {
+		return true
+	}
+	severity, scoped := eventSeverity(event)
+	if !scoped {
+		return true
+	}
+	return minSeverityRank[severity] >= minSeverityRank[minSeverity]
+}
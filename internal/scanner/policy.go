@@ -0,0 +1,63 @@
+package scanner
+
+import "fmt"
+
+// Rule is a single compliance check that can be evaluated against an Asset.
+// Implementations may be hand-written in Go, loaded from a Rego policy file,
+// or loaded from a YAML rule definition — CheckCompliance no longer cares
+// which.
+type Rule interface {
+	ID() string
+	Severity() string
+	Evaluate(a *Asset) []Violation
+}
+
+// PolicyEngine loads rules from a policy directory and evaluates them
+// against assets. It replaces the hard-coded if-statements that used to
+// live in CheckCompliance.
+type PolicyEngine struct {
+	rules []Rule
+}
+
+// NewPolicyEngine loads every policy file under dir and returns an engine
+// ready to evaluate assets. Rego policies (*.rego) are loaded via
+// loadRegoRule, YAML rules (*.yaml/*.yml) via loadYAMLRule.
+func NewPolicyEngine(dir string) (*PolicyEngine, error) {
+	files, err := policyFiles(dir)
+	if err != nil {
+		return nil, fmt.Errorf("policy: reading policy dir %q: %w", dir, err)
+	}
+
+	engine := &PolicyEngine{}
+	for _, f := range files {
+		rule, err := loadRule(f)
+		if err != nil {
+			return nil, fmt.Errorf("policy: loading rule %q: %w", f, err)
+		}
+		engine.rules = append(engine.rules, rule)
+	}
+	return engine, nil
+}
+
+// Evaluate runs every loaded rule against the asset and returns the
+// aggregated list of violations.
+func (e *PolicyEngine) Evaluate(a *Asset) []Violation {
+	var violations []Violation
+	for _, rule := range e.rules {
+		violations = append(violations, rule.Evaluate(a)...)
+	}
+	return violations
+}
+
+// Rules returns the rules currently loaded into the engine, in load order.
+func (e *PolicyEngine) Rules() []Rule {
+	return e.rules
+}
+
+// CheckCompliance runs the policy engine's rules against a single asset and
+// records the resulting score and violations on it, using policy to turn
+// violations into a ComplianceScore.
+func CheckCompliance(a *Asset, engine *PolicyEngine, policy *ScoringPolicy) {
+	a.Violations = engine.Evaluate(a)
+	a.ComplianceScore = policy.Score(a.Violations)
+}
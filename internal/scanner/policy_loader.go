@@ -0,0 +1,38 @@
+package scanner
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// policyFiles returns the rule files (*.rego, *.yaml, *.yml) found directly
+// under dir, sorted by name so engine behavior is deterministic.
+func policyFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		switch strings.ToLower(filepath.Ext(e.Name())) {
+		case ".rego", ".yaml", ".yml":
+			files = append(files, filepath.Join(dir, e.Name()))
+		}
+	}
+	return files, nil
+}
+
+// loadRule loads a single rule from path, dispatching on file extension.
+func loadRule(path string) (Rule, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".rego":
+		return loadRegoRule(path)
+	default:
+		return loadYAMLRule(path)
+	}
+}
@@ -0,0 +1,136 @@
+package scanner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/open-policy-agent/opa/rego"
+)
+
+// regoRule evaluates an Asset against a compiled Rego policy. The policy is
+// expected to expose `violations` as a list of objects shaped like
+// {"rule_id": ..., "description": ..., "severity": ...}, given `input` set
+// to the JSON representation of the Asset being scanned.
+type regoRule struct {
+	id       string
+	severity string
+	query    rego.PreparedEvalQuery
+}
+
+// loadRegoRule compiles the Rego policy at path and wraps it as a Rule. The
+// rule's ID is the policy's package name, so it is available even before the
+// policy has run (e.g. for `policy validate`). The rule's Severity is always
+// HIGH; nothing in the policy file is read to set it, and the severities of
+// the violations the policy returns are kept as the policy reports them.
+func loadRegoRule(path string) (*regoRule, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	r := rego.New(
+		rego.Query("data."+regoPackageName(raw)+".violations"),
+		rego.Load([]string{path}, nil),
+	)
+
+	query, err := r.PrepareForEval(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("preparing rego policy %q: %w", path, err)
+	}
+
+	return &regoRule{
+		id:       regoPackageName(raw),
+		severity: "HIGH",
+		query:    query,
+	}, nil
+}
+
+func (r *regoRule) ID() string       { return r.id }
+func (r *regoRule) Severity() string { return r.severity }
+
+// Evaluate marshals the asset to JSON, feeds it to the compiled policy as
+// `input`, and decodes the returned violation list.
+func (r *regoRule) Evaluate(a *Asset) []Violation {
+	input, err := assetToInput(a)
+	if err != nil {
+		return nil
+	}
+
+	results, err := r.query.Eval(context.Background(), rego.EvalInput(input))
+	if err != nil || len(results) == 0 || len(results[0].Expressions) == 0 {
+		return nil
+	}
+
+	raw, err := json.Marshal(results[0].Expressions[0].Value)
+	if err != nil {
+		return nil
+	}
+
+	var decoded []struct {
+		RuleID      string `json:"rule_id"`
+		Description string `json:"description"`
+		Severity    string `json:"severity"`
+	}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return nil
+	}
+
+	violations := make([]Violation, 0, len(decoded))
+	for _, v := range decoded {
+		violations = append(violations, Violation{
+			RuleID:      v.RuleID,
+			Description: v.Description,
+			Severity:    v.Severity,
+		})
+	}
+	return violations
+}
+
+// assetToInput round-trips the Asset through JSON so the Rego policy sees
+// the same field names our reporters do.
+func assetToInput(a *Asset) (map[string]interface{}, error) {
+	raw, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	var input map[string]interface{}
+	if err := json.Unmarshal(raw, &input); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// regoPackageName does a minimal scan for the `package` declaration so we
+// don't need a full parse just to build the query path.
+func regoPackageName(src []byte) string {
+	const prefix = "package "
+	s := string(src)
+	for _, line := range splitLines(s) {
+		if len(line) > len(prefix) && line[:len(prefix)] == prefix {
+			return line[len(prefix):]
+		}
+	}
+	return "policy"
+}
+
+func splitLines(s string) []string {
+	var lines []string
+	start := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\n' {
+			lines = append(lines, trimRight(s[start:i]))
+			start = i + 1
+		}
+	}
+	lines = append(lines, trimRight(s[start:]))
+	return lines
+}
+
+func trimRight(s string) string {
+	for len(s) > 0 && (s[len(s)-1] == '\r' || s[len(s)-1] == ' ' || s[len(s)-1] == '\t') {
+		s = s[:len(s)-1]
+	}
+	return s
+}
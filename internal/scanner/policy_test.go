@@ -0,0 +1,48 @@
+package scanner
+
+import "testing"
+
+// TestShippedYAMLPoliciesLoad guards against the default rules under
+// policies/ failing to compile — e.g. a `when` expression referencing an
+// identifier the evaluation env doesn't expose, which would make
+// NewPolicyEngine error on every scan that uses the default --policy-dir.
+func TestShippedYAMLPoliciesLoad(t *testing.T) {
+	engine, err := NewPolicyEngine("../../policies")
+	if err != nil {
+		t.Fatalf("NewPolicyEngine(policies): %v", err)
+	}
+	if len(engine.Rules()) != 2 {
+		t.Fatalf("got %d rules, want 2 (SEC_R01, TAG_R02)", len(engine.Rules()))
+	}
+}
+
+// TestShippedYAMLPoliciesFlagMockAssets pins the default rules' behavior
+// against GenerateMockAssets, preserving the pre-policy-engine compliance
+// results for gcp-001 (public bucket) and gcp-002 (untagged dev VM).
+func TestShippedYAMLPoliciesFlagMockAssets(t *testing.T) {
+	engine, err := NewPolicyEngine("../../policies")
+	if err != nil {
+		t.Fatalf("NewPolicyEngine(policies): %v", err)
+	}
+	policy := defaultScoringPolicy()
+
+	assets := GenerateMockAssets()
+	for i := range assets {
+		CheckCompliance(&assets[i], engine, policy)
+	}
+
+	byID := make(map[string]Asset, len(assets))
+	for _, a := range assets {
+		byID[a.ID] = a
+	}
+
+	if v := byID["gcp-001"].Violations; len(v) != 1 || v[0].RuleID != "SEC_R01" {
+		t.Errorf("gcp-001 violations = %+v, want a single SEC_R01 violation", v)
+	}
+	if v := byID["gcp-002"].Violations; len(v) != 1 || v[0].RuleID != "TAG_R02" {
+		t.Errorf("gcp-002 violations = %+v, want a single TAG_R02 violation", v)
+	}
+	if v := byID["gcp-003"].Violations; len(v) != 0 {
+		t.Errorf("gcp-003 violations = %+v, want none", v)
+	}
+}
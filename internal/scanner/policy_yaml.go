@@ -0,0 +1,100 @@
+package scanner
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+	"gopkg.in/yaml.v3"
+)
+
+// yamlRuleSpec is the on-disk shape of a simple YAML rule file, e.g.:
+//
+//	id: SEC_R01
+//	severity: CRITICAL
+//	description: Publicly exposed storage bucket.
+//	match:
+//	  type: STORAGE_BUCKET
+//	when: "asset.IsPublic == true"
+type yamlRuleSpec struct {
+	ID          string `yaml:"id"`
+	Severity    string `yaml:"severity"`
+	Description string `yaml:"description"`
+	Match       struct {
+		Type string `yaml:"type"`
+	} `yaml:"match"`
+	When string `yaml:"when"`
+}
+
+// yamlRule evaluates an Asset against a compiled `when` expression, after
+// first checking that the asset matches the rule's `match` filter.
+type yamlRule struct {
+	spec    yamlRuleSpec
+	program *vm.Program
+}
+
+// loadYAMLRule parses the YAML rule file at path and compiles its `when`
+// expression with expr-lang, using an `asset` variable bound to the Asset
+// being evaluated.
+func loadYAMLRule(path string) (*yamlRule, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec yamlRuleSpec
+	if err := yaml.Unmarshal(raw, &spec); err != nil {
+		return nil, fmt.Errorf("parsing yaml rule: %w", err)
+	}
+	if spec.ID == "" {
+		return nil, fmt.Errorf("yaml rule %q is missing an id", path)
+	}
+
+	program, err := expr.Compile(spec.When, expr.Env(newYAMLRuleEnv(nil)))
+	if err != nil {
+		return nil, fmt.Errorf("compiling `when` expression for rule %q: %w", spec.ID, err)
+	}
+
+	return &yamlRule{spec: spec, program: program}, nil
+}
+
+func (r *yamlRule) ID() string       { return r.spec.ID }
+func (r *yamlRule) Severity() string { return r.spec.Severity }
+
+// newYAMLRuleEnv builds the evaluation environment exposed to `when`
+// expressions. It's a plain map rather than a struct so the identifier
+// visible to expressions is the lowercase `asset` used throughout
+// policies/*.yaml — expr-lang matches struct-based env identifiers against
+// the Go field name, which would otherwise force `when` clauses to write
+// `Asset`. It must stay an unnamed map[string]any (not a defined type):
+// expr's VM fast-paths map envs with a type assertion to map[string]any,
+// which fails silently against a named type and makes every rule evaluate
+// to false.
+func newYAMLRuleEnv(a *Asset) map[string]any {
+	return map[string]any{"asset": a}
+}
+
+// Evaluate checks the rule's match filter, then runs the compiled `when`
+// expression; a true result produces a single Violation.
+func (r *yamlRule) Evaluate(a *Asset) []Violation {
+	if r.spec.Match.Type != "" && r.spec.Match.Type != a.Type {
+		return nil
+	}
+
+	out, err := expr.Run(r.program, newYAMLRuleEnv(a))
+	if err != nil {
+		return nil
+	}
+
+	matched, ok := out.(bool)
+	if !ok || !matched {
+		return nil
+	}
+
+	return []Violation{{
+		RuleID:      r.spec.ID,
+		Description: r.spec.Description,
+		Severity:    r.spec.Severity,
+	}}
+}
@@ -0,0 +1,45 @@
+package scanner
+
+import "context"
+
+// AssetProvider discovers cloud assets from a single cloud account or
+// subscription. Each concrete provider is responsible for translating its
+// cloud's native resource shape into our generic Asset.
+type AssetProvider interface {
+	// Name identifies the provider for logging and the --provider flag,
+	// e.g. "aws", "azure", "gcp", "mock".
+	Name() string
+	// Discover returns every asset the provider can see with its currently
+	// configured credentials.
+	Discover(ctx context.Context) ([]Asset, error)
+}
+
+// providerRegistry maps the --provider flag values to constructors. Adding a
+// new cloud only requires registering it here.
+var providerRegistry = map[string]func() AssetProvider{
+	"mock":      func() AssetProvider { return &mockProvider{} },
+	"aws":       func() AssetProvider { return newAWSProvider() },
+	"azure":     func() AssetProvider { return newAzureProvider() },
+	"gcp":       func() AssetProvider { return newGCPProvider() },
+	"inventory": func() AssetProvider { return &inventoryProvider{store: NewInventoryStore(DefaultInventoryPath)} },
+}
+
+// ResolveProviders turns the --provider flag values into AssetProvider
+// instances, erroring on unknown names.
+func ResolveProviders(names []string) ([]AssetProvider, error) {
+	providers := make([]AssetProvider, 0, len(names))
+	for _, name := range names {
+		ctor, ok := providerRegistry[name]
+		if !ok {
+			return nil, unknownProviderError(name)
+		}
+		providers = append(providers, ctor())
+	}
+	return providers, nil
+}
+
+type unknownProviderError string
+
+func (e unknownProviderError) Error() string {
+	return "unknown provider " + string(e) + " (want one of: mock, aws, azure, gcp, inventory)"
+}
@@ -0,0 +1,130 @@
+package scanner
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// awsProvider discovers EC2 instances and S3 buckets using the default AWS
+// credential chain (env vars, shared config, instance role, ...).
+type awsProvider struct{}
+
+func newAWSProvider() *awsProvider { return &awsProvider{} }
+
+func (p *awsProvider) Name() string { return "aws" }
+
+func (p *awsProvider) Discover(ctx context.Context) ([]Asset, error) {
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("aws: loading default config: %w", err)
+	}
+
+	var assets []Asset
+
+	instances, err := discoverEC2Instances(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("aws: discovering EC2 instances: %w", err)
+	}
+	assets = append(assets, instances...)
+
+	buckets, err := discoverS3Buckets(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("aws: discovering S3 buckets: %w", err)
+	}
+	assets = append(assets, buckets...)
+
+	return assets, nil
+}
+
+func discoverEC2Instances(ctx context.Context, cfg aws.Config) ([]Asset, error) {
+	client := ec2.NewFromConfig(cfg)
+
+	var assets []Asset
+	paginator := ec2.NewDescribeInstancesPaginator(client, &ec2.DescribeInstancesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, reservation := range page.Reservations {
+			for _, inst := range reservation.Instances {
+				assets = append(assets, Asset{
+					ID:       aws.ToString(inst.InstanceId),
+					Type:     "VM_INSTANCE",
+					Name:     ec2NameTag(inst.Tags),
+					IsPublic: inst.PublicIpAddress != nil,
+					Tags:     ec2Tags(inst.Tags),
+				})
+			}
+		}
+	}
+	return assets, nil
+}
+
+func discoverS3Buckets(ctx context.Context, cfg aws.Config) ([]Asset, error) {
+	client := s3.NewFromConfig(cfg)
+
+	list, err := client.ListBuckets(ctx, &s3.ListBucketsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	assets := make([]Asset, 0, len(list.Buckets))
+	for _, bucket := range list.Buckets {
+		name := aws.ToString(bucket.Name)
+
+		public, err := s3BucketIsPublic(ctx, client, name)
+		if err != nil {
+			return nil, fmt.Errorf("checking public access for bucket %q: %w", name, err)
+		}
+
+		assets = append(assets, Asset{
+			ID:       name,
+			Type:     "STORAGE_BUCKET",
+			Name:     name,
+			IsPublic: public,
+		})
+	}
+	return assets, nil
+}
+
+// s3BucketIsPublic inspects the bucket's PublicAccessBlock configuration and
+// treats the bucket as public unless every block setting is enabled.
+func s3BucketIsPublic(ctx context.Context, client *s3.Client, bucket string) (bool, error) {
+	out, err := client.GetPublicAccessBlock(ctx, &s3.GetPublicAccessBlockInput{Bucket: aws.String(bucket)})
+	if err != nil {
+		// Buckets with no PublicAccessBlock configuration have none of the
+		// protections enabled, which we treat as publicly reachable.
+		return true, nil
+	}
+
+	cfg := out.PublicAccessBlockConfiguration
+	allBlocked := aws.ToBool(cfg.BlockPublicAcls) &&
+		aws.ToBool(cfg.BlockPublicPolicy) &&
+		aws.ToBool(cfg.IgnorePublicAcls) &&
+		aws.ToBool(cfg.RestrictPublicBuckets)
+	return !allBlocked, nil
+}
+
+func ec2Tags(tags []ec2types.Tag) []string {
+	out := make([]string, 0, len(tags))
+	for _, t := range tags {
+		out = append(out, aws.ToString(t.Value))
+	}
+	return out
+}
+
+func ec2NameTag(tags []ec2types.Tag) string {
+	for _, t := range tags {
+		if aws.ToString(t.Key) == "Name" {
+			return aws.ToString(t.Value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,137 @@
+package scanner
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+// gcpProvider discovers Compute Engine instances and Cloud Storage buckets
+// in a single GCP project, resolved from GOOGLE_CLOUD_PROJECT and Application
+// Default Credentials.
+type gcpProvider struct {
+	projectID string
+}
+
+func newGCPProvider() *gcpProvider {
+	return &gcpProvider{projectID: os.Getenv("GOOGLE_CLOUD_PROJECT")}
+}
+
+func (p *gcpProvider) Name() string { return "gcp" }
+
+func (p *gcpProvider) Discover(ctx context.Context) ([]Asset, error) {
+	if p.projectID == "" {
+		return nil, fmt.Errorf("gcp: GOOGLE_CLOUD_PROJECT is not set")
+	}
+
+	var assets []Asset
+
+	instances, err := discoverGCPInstances(ctx, p.projectID)
+	if err != nil {
+		return nil, fmt.Errorf("gcp: discovering Compute instances: %w", err)
+	}
+	assets = append(assets, instances...)
+
+	buckets, err := discoverGCPBuckets(ctx, p.projectID)
+	if err != nil {
+		return nil, fmt.Errorf("gcp: discovering Storage buckets: %w", err)
+	}
+	assets = append(assets, buckets...)
+
+	return assets, nil
+}
+
+func discoverGCPInstances(ctx context.Context, projectID string) ([]Asset, error) {
+	client, err := compute.NewInstancesRESTClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	var assets []Asset
+	it := client.AggregatedList(ctx, &computepb.AggregatedListInstancesRequest{Project: projectID})
+	for {
+		pair, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, inst := range pair.Value.Instances {
+			assets = append(assets, Asset{
+				ID:       fmt.Sprintf("%d", inst.GetId()),
+				Type:     "VM_INSTANCE",
+				Name:     inst.GetName(),
+				IsPublic: instanceHasExternalIP(inst),
+				Tags:     inst.GetTags().GetItems(),
+			})
+		}
+	}
+	return assets, nil
+}
+
+func instanceHasExternalIP(inst *computepb.Instance) bool {
+	for _, iface := range inst.GetNetworkInterfaces() {
+		if len(iface.GetAccessConfigs()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func discoverGCPBuckets(ctx context.Context, projectID string) ([]Asset, error) {
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	var assets []Asset
+	it := client.Buckets(ctx, projectID)
+	for {
+		bucket, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		public, err := bucketAllowsAllUsers(ctx, client, bucket.Name)
+		if err != nil {
+			return nil, fmt.Errorf("checking IAM policy for bucket %q: %w", bucket.Name, err)
+		}
+
+		assets = append(assets, Asset{
+			ID:       bucket.Name,
+			Type:     "STORAGE_BUCKET",
+			Name:     bucket.Name,
+			IsPublic: public,
+		})
+	}
+	return assets, nil
+}
+
+// bucketAllowsAllUsers reports whether the bucket's IAM policy grants any
+// role to allUsers or allAuthenticatedUsers — GCS's equivalent of a public
+// ACL.
+func bucketAllowsAllUsers(ctx context.Context, client *storage.Client, name string) (bool, error) {
+	policy, err := client.Bucket(name).IAM().Policy(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, role := range policy.Roles() {
+		for _, member := range policy.Members(role) {
+			if member == "allUsers" || member == "allAuthenticatedUsers" {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,14 @@
+package scanner
+
+import "context"
+
+// mockProvider serves the same fixed asset list the scanner shipped with
+// before real cloud providers existed. It's kept around behind
+// --provider=mock so demos and tests don't need live cloud credentials.
+type mockProvider struct{}
+
+func (p *mockProvider) Name() string { return "mock" }
+
+func (p *mockProvider) Discover(ctx context.Context) ([]Asset, error) {
+	return GenerateMockAssets(), nil
+}
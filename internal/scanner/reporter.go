@@ -0,0 +1,47 @@
+package scanner
+
+import "fmt"
+
+// Reporter renders a completed scan (assets plus the policy engine that
+// produced their violations) to some output format. generateReport used to
+// hard-code a single JSON writer; reporters are now selected at runtime via
+// the --output flag.
+type Reporter interface {
+	// Name is the --output flag value that selects this reporter.
+	Name() string
+	// Report renders assets to the given path. An empty path means
+	// "write to a sensible default for this format". The human reporter
+	// always prints to stdout and ignores path.
+	Report(assets []Asset, engine *PolicyEngine, path string) error
+}
+
+// reporterRegistry maps --output flag values to reporter instances.
+var reporterRegistry = map[string]Reporter{
+	"human": humanReporter{},
+	"json":  jsonReporter{},
+	"yaml":  yamlReporter{},
+	"xml":   xmlReporter{},
+	"sarif": sarifReporter{},
+	"junit": junitReporter{},
+}
+
+// ResolveReporter looks up a reporter by --output flag value.
+func ResolveReporter(name string) (Reporter, error) {
+	reporter, ok := reporterRegistry[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown output format %q (want one of: human, json, yaml, xml, sarif, junit)", name)
+	}
+	return reporter, nil
+}
+
+// FailedAssets returns the subset of assets that aren't fully compliant, the
+// same filter generateReport used to apply inline.
+func FailedAssets(assets []Asset) []Asset {
+	var failed []Asset
+	for _, a := range assets {
+		if a.ComplianceScore < 100 {
+			failed = append(failed, a)
+		}
+	}
+	return failed
+}
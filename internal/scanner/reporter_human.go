@@ -0,0 +1,107 @@
+package scanner
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ANSI colors for the human reporter. No-op if the terminal doesn't honor
+// them, which is an acceptable tradeoff for a CLI tool like this one.
+const (
+	colorReset  = "\033[0m"
+	colorRed    = "\033[31m"
+	colorYellow = "\033[33m"
+	colorBlue   = "\033[34m"
+	colorGray   = "\033[90m"
+	colorGreen  = "\033[32m"
+)
+
+var severityOrder = []string{"CRITICAL", "HIGH", "MEDIUM", "LOW"}
+
+var severityColor = map[string]string{
+	"CRITICAL": colorRed,
+	"HIGH":     colorYellow,
+	"MEDIUM":   colorBlue,
+	"LOW":      colorGray,
+}
+
+// humanReporter renders a colorized table of violations grouped by
+// severity, followed by a pass/fail/score summary footer. It's the default
+// --output for interactive use.
+type humanReporter struct{}
+
+func (humanReporter) Name() string { return "human" }
+
+func (humanReporter) Report(assets []Asset, engine *PolicyEngine, path string) error {
+	bySeverity := map[string][]violationRow{}
+	for _, a := range assets {
+		for _, v := range a.Violations {
+			bySeverity[v.Severity] = append(bySeverity[v.Severity], violationRow{asset: a, violation: v})
+		}
+	}
+
+	for _, severity := range orderedSeverities(bySeverity) {
+		rows := bySeverity[severity]
+		color := severityColor[severity]
+		fmt.Printf("\n%s%s (%d)%s\n", color, severity, len(rows), colorReset)
+		fmt.Println("ASSET ID            RULE        DESCRIPTION")
+		for _, row := range rows {
+			fmt.Printf("%-20s%-12s%s\n", row.asset.ID, row.violation.RuleID, row.violation.Description)
+		}
+	}
+
+	printSummaryFooter(assets)
+	return nil
+}
+
+type violationRow struct {
+	asset     Asset
+	violation Violation
+}
+
+// orderedSeverities returns the severities present in bySeverity, in the
+// fixed CRITICAL > HIGH > MEDIUM > LOW order, with any unrecognized
+// severity appended alphabetically at the end.
+func orderedSeverities(bySeverity map[string][]violationRow) []string {
+	var ordered []string
+	seen := map[string]bool{}
+	for _, s := range severityOrder {
+		if _, ok := bySeverity[s]; ok {
+			ordered = append(ordered, s)
+			seen[s] = true
+		}
+	}
+	var extra []string
+	for s := range bySeverity {
+		if !seen[s] {
+			extra = append(extra, s)
+		}
+	}
+	sort.Strings(extra)
+	return append(ordered, extra...)
+}
+
+func printSummaryFooter(assets []Asset) {
+	pass, fail := 0, 0
+	scores := map[int]int{}
+	for _, a := range assets {
+		if a.ComplianceScore >= 100 {
+			pass++
+		} else {
+			fail++
+		}
+		scores[a.ComplianceScore]++
+	}
+
+	fmt.Printf("\n%s--- Summary ---%s\n", colorGray, colorReset)
+	fmt.Printf("%sPASS: %d%s   %sFAIL: %d%s\n", colorGreen, pass, colorReset, colorRed, fail, colorReset)
+
+	var distinctScores []int
+	for score := range scores {
+		distinctScores = append(distinctScores, score)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(distinctScores)))
+	for _, score := range distinctScores {
+		fmt.Printf("  score %-4d: %d asset(s)\n", score, scores[score])
+	}
+}
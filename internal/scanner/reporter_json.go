@@ -0,0 +1,37 @@
+package scanner
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// jsonReporter preserves the scanner's original output format: a JSON array
+// of the failed assets.
+type jsonReporter struct{}
+
+func (jsonReporter) Name() string { return "json" }
+
+func (jsonReporter) Report(assets []Asset, engine *PolicyEngine, path string) error {
+	if path == "" {
+		path = "compliance_report.json"
+	}
+
+	failed := FailedAssets(assets)
+	if len(failed) == 0 {
+		fmt.Println("No compliance failures found. Clean run!")
+		return nil
+	}
+
+	data, err := json.MarshalIndent(failed, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshalling JSON report: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing JSON report: %w", err)
+	}
+
+	fmt.Printf("✅ Detailed report for %d failed assets written to: %s\n", len(failed), path)
+	return nil
+}
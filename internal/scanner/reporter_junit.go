@@ -0,0 +1,79 @@
+package scanner
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+)
+
+// JUnit XML structures, modeled so violations show up as failed test cases
+// in CI dashboards that already understand JUnit reports.
+type junitTestSuite struct {
+	XMLName   xml.Name        `xml:"testsuite"`
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name    string        `xml:"name,attr"`
+	Failure *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// junitReporter renders one testcase per asset, marked as a failure listing
+// every violation found for that asset.
+type junitReporter struct{}
+
+func (junitReporter) Name() string { return "junit" }
+
+func (junitReporter) Report(assets []Asset, engine *PolicyEngine, path string) error {
+	if path == "" {
+		path = "compliance_report.junit.xml"
+	}
+
+	suite := junitTestSuite{
+		Name:  "compliance",
+		Tests: len(assets),
+	}
+
+	for _, a := range assets {
+		tc := junitTestCase{Name: fmt.Sprintf("%s (%s)", a.ID, a.Type)}
+		if len(a.Violations) > 0 {
+			suite.Failures++
+			tc.Failure = &junitFailure{
+				Message: fmt.Sprintf("%d violation(s), score %d", len(a.Violations), a.ComplianceScore),
+				Type:    "ComplianceViolation",
+				Text:    junitViolationText(a.Violations),
+			}
+		}
+		suite.TestCases = append(suite.TestCases, tc)
+	}
+
+	data, err := xml.MarshalIndent(suite, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshalling JUnit report: %w", err)
+	}
+	data = append([]byte(xml.Header), data...)
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing JUnit report: %w", err)
+	}
+
+	fmt.Printf("✅ JUnit report written to: %s\n", path)
+	return nil
+}
+
+func junitViolationText(violations []Violation) string {
+	text := ""
+	for _, v := range violations {
+		text += fmt.Sprintf("[%s] %s: %s\n", v.Severity, v.RuleID, v.Description)
+	}
+	return text
+}
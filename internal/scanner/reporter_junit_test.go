@@ -0,0 +1,44 @@
+package scanner
+
+import (
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestJUnitReporterReport(t *testing.T) {
+	assets := []Asset{
+		{ID: "gcp-001", Type: "STORAGE_BUCKET", ComplianceScore: 50, Violations: []Violation{
+			{RuleID: "SEC_R01", Description: "Publicly exposed storage bucket.", Severity: "CRITICAL"},
+		}},
+		{ID: "gcp-003", Type: "STORAGE_BUCKET", ComplianceScore: 100},
+	}
+
+	path := filepath.Join(t.TempDir(), "report.junit.xml")
+	if err := (junitReporter{}).Report(assets, nil, path); err != nil {
+		t.Fatalf("Report: %v", err)
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading report: %v", err)
+	}
+
+	var suite junitTestSuite
+	if err := xml.Unmarshal(raw, &suite); err != nil {
+		t.Fatalf("unmarshalling report: %v", err)
+	}
+	if suite.Tests != 2 {
+		t.Errorf("suite.Tests = %d, want 2", suite.Tests)
+	}
+	if suite.Failures != 1 {
+		t.Errorf("suite.Failures = %d, want 1", suite.Failures)
+	}
+	if tc := suite.TestCases[0]; tc.Failure == nil {
+		t.Errorf("gcp-001 testcase has no failure, want one for its violation")
+	}
+	if tc := suite.TestCases[1]; tc.Failure != nil {
+		t.Errorf("gcp-003 testcase has a failure, want none (fully compliant)")
+	}
+}
@@ -0,0 +1,146 @@
+package scanner
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// SARIF 2.1.0 structures. Only the fields this scanner needs are modeled;
+// see https://docs.oasis-open.org/sarif/sarif/v2.1.0/ for the full schema.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name    string                     `json:"name"`
+	Version string                     `json:"version"`
+	Rules   []sarifReportingDescriptor `json:"rules"`
+}
+
+type sarifReportingDescriptor struct {
+	ID               string               `json:"id"`
+	ShortDescription sarifMultiformatText `json:"shortDescription"`
+}
+
+type sarifMultiformatText struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string               `json:"ruleId"`
+	Level     string               `json:"level"`
+	Message   sarifMultiformatText `json:"message"`
+	Locations []sarifLocation      `json:"locations"`
+}
+
+type sarifLocation struct {
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+	Properties       sarifLocationProps     `json:"properties"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+type sarifLocationProps struct {
+	Type     string   `json:"type"`
+	Tags     []string `json:"tags"`
+	IsPublic bool     `json:"isPublic"`
+}
+
+// sarifReporter emits a SARIF 2.1.0 log suitable for GitHub code scanning or
+// DefectDojo ingestion.
+type sarifReporter struct{}
+
+func (sarifReporter) Name() string { return "sarif" }
+
+func (sarifReporter) Report(assets []Asset, engine *PolicyEngine, path string) error {
+	if path == "" {
+		path = "compliance_report.sarif"
+	}
+
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:    "go-cloud-scanner",
+			Version: "1.0.0",
+			Rules:   sarifRulesFromEngine(engine),
+		}},
+	}
+
+	for _, a := range assets {
+		for _, v := range a.Violations {
+			run.Results = append(run.Results, sarifResult{
+				RuleID:  v.RuleID,
+				Level:   sarifLevel(v.Severity),
+				Message: sarifMultiformatText{Text: v.Description},
+				Locations: []sarifLocation{{
+					LogicalLocations: []sarifLogicalLocation{{FullyQualifiedName: a.ID}},
+					Properties: sarifLocationProps{
+						Type:     a.Type,
+						Tags:     a.Tags,
+						IsPublic: a.IsPublic,
+					},
+				}},
+			})
+		}
+	}
+
+	log := sarifLog{
+		Schema:  "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}
+
+	data, err := json.MarshalIndent(log, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshalling SARIF report: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing SARIF report: %w", err)
+	}
+
+	fmt.Printf("✅ SARIF report written to: %s\n", path)
+	return nil
+}
+
+// sarifRulesFromEngine turns the engine's loaded rules into SARIF
+// reportingDescriptors so every rule is declared under tool.driver.rules,
+// even ones that produced no violations this run.
+func sarifRulesFromEngine(engine *PolicyEngine) []sarifReportingDescriptor {
+	if engine == nil {
+		return nil
+	}
+	descriptors := make([]sarifReportingDescriptor, 0, len(engine.Rules()))
+	for _, rule := range engine.Rules() {
+		descriptors = append(descriptors, sarifReportingDescriptor{
+			ID:               rule.ID(),
+			ShortDescription: sarifMultiformatText{Text: fmt.Sprintf("%s severity rule %s", rule.Severity(), rule.ID())},
+		})
+	}
+	return descriptors
+}
+
+// sarifLevel maps our severities onto SARIF's result.level enum.
+func sarifLevel(severity string) string {
+	switch severity {
+	case "CRITICAL", "HIGH":
+		return "error"
+	case "MEDIUM":
+		return "warning"
+	default:
+		return "note"
+	}
+}
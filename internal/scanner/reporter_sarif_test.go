@@ -0,0 +1,54 @@
+package scanner
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSARIFReporterReport(t *testing.T) {
+	engine := &PolicyEngine{rules: []Rule{
+		&yamlRule{spec: yamlRuleSpec{ID: "SEC_R01", Severity: "CRITICAL"}},
+	}}
+	assets := []Asset{
+		{
+			ID:         "gcp-001",
+			Type:       "STORAGE_BUCKET",
+			IsPublic:   true,
+			Violations: []Violation{{RuleID: "SEC_R01", Description: "Publicly exposed storage bucket.", Severity: "CRITICAL"}},
+		},
+	}
+
+	path := filepath.Join(t.TempDir(), "report.sarif")
+	if err := (sarifReporter{}).Report(assets, engine, path); err != nil {
+		t.Fatalf("Report: %v", err)
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading report: %v", err)
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal(raw, &log); err != nil {
+		t.Fatalf("unmarshalling report: %v", err)
+	}
+	if len(log.Runs) != 1 {
+		t.Fatalf("got %d runs, want 1", len(log.Runs))
+	}
+	run := log.Runs[0]
+	if len(run.Tool.Driver.Rules) != 1 || run.Tool.Driver.Rules[0].ID != "SEC_R01" {
+		t.Errorf("driver rules = %+v, want one descriptor for SEC_R01", run.Tool.Driver.Rules)
+	}
+	if len(run.Results) != 1 || run.Results[0].Level != "error" {
+		t.Errorf("results = %+v, want one CRITICAL result at level=error", run.Results)
+	}
+}
+
+func TestSARIFReporterReportNilEngine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.sarif")
+	if err := (sarifReporter{}).Report(nil, nil, path); err != nil {
+		t.Fatalf("Report with nil engine: %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package scanner
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+)
+
+// xmlReport is the XML-serializable shape of a scan. Asset has no xml tags,
+// so marshalling it directly would repeat a <Violations> and a <Tags> element
+// per entry; xmlAsset mirrors its fields and nests them under wrapper
+// elements instead (<Violations><Violation>, <Tags><Tag>).
+type xmlReport struct {
+	XMLName xml.Name   `xml:"ComplianceReport"`
+	Assets  []xmlAsset `xml:"Asset"`
+}
+
+type xmlAsset struct {
+	ID              string      `xml:"ID"`
+	Type            string      `xml:"Type"`
+	Name            string      `xml:"Name"`
+	IsPublic        bool        `xml:"IsPublic"`
+	ComplianceScore int         `xml:"ComplianceScore"`
+	Violations      []Violation `xml:"Violations>Violation"`
+	Tags            []string    `xml:"Tags>Tag"`
+}
+
+// xmlReporter writes the failed assets as an XML document.
+type xmlReporter struct{}
+
+func (xmlReporter) Name() string { return "xml" }
+
+func (xmlReporter) Report(assets []Asset, engine *PolicyEngine, path string) error {
+	if path == "" {
+		path = "compliance_report.xml"
+	}
+
+	failed := FailedAssets(assets)
+	if len(failed) == 0 {
+		fmt.Println("No compliance failures found. Clean run!")
+		return nil
+	}
+
+	report := xmlReport{Assets: make([]xmlAsset, 0, len(failed))}
+	for _, a := range failed {
+		report.Assets = append(report.Assets, xmlAsset{
+			ID:              a.ID,
+			Type:            a.Type,
+			Name:            a.Name,
+			IsPublic:        a.IsPublic,
+			ComplianceScore: a.ComplianceScore,
+			Violations:      a.Violations,
+			Tags:            a.Tags,
+		})
+	}
+
+	data, err := xml.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshalling XML report: %w", err)
+	}
+	data = append([]byte(xml.Header), data...)
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing XML report: %w", err)
+	}
+
+	fmt.Printf("✅ Detailed report for %d failed assets written to: %s\n", len(failed), path)
+	return nil
+}
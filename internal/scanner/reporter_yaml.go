@@ -0,0 +1,38 @@
+package scanner
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// yamlReporter writes the failed assets as a YAML document, for users who
+// feed scan output into YAML-native tooling.
+type yamlReporter struct{}
+
+func (yamlReporter) Name() string { return "yaml" }
+
+func (yamlReporter) Report(assets []Asset, engine *PolicyEngine, path string) error {
+	if path == "" {
+		path = "compliance_report.yaml"
+	}
+
+	failed := FailedAssets(assets)
+	if len(failed) == 0 {
+		fmt.Println("No compliance failures found. Clean run!")
+		return nil
+	}
+
+	data, err := yaml.Marshal(failed)
+	if err != nil {
+		return fmt.Errorf("marshalling YAML report: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing YAML report: %w", err)
+	}
+
+	fmt.Printf("✅ Detailed report for %d failed assets written to: %s\n", len(failed), path)
+	return nil
+}
@@ -0,0 +1,174 @@
+package scanner
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Exit codes, so this scanner can gate CI the way Terrascan and similar
+// IaC scanners do.
+const (
+	ExitClean             = 0
+	ExitViolationsFound   = 3
+	ExitThresholdBreached = 4
+	ExitRuntimeError      = 1
+)
+
+// AggregationMode selects how per-violation weights combine into a final
+// score.
+type AggregationMode string
+
+const (
+	// AggregationSubtract starts at 100 and subtracts each violation's
+	// weight — the scanner's original behavior.
+	AggregationSubtract AggregationMode = "subtract"
+	// AggregationMultiplicative starts at 100 and multiplies by
+	// (1 - weight/100) per violation, so repeated low-severity findings
+	// compound instead of adding linearly.
+	AggregationMultiplicative AggregationMode = "multiplicative"
+	// AggregationCVSSLike takes the single highest-weighted violation as
+	// the score's penalty, mirroring how CVSS bases a score on the worst
+	// individual factor rather than summing everything.
+	AggregationCVSSLike AggregationMode = "cvss-like"
+)
+
+// ScoringPolicy configures how violations turn into a ComplianceScore.
+type ScoringPolicy struct {
+	Weights       map[string]int  `yaml:"weights"`
+	RuleOverrides map[string]int  `yaml:"rule_overrides"`
+	Aggregation   AggregationMode `yaml:"aggregation"`
+}
+
+// defaultScoringPolicy preserves the exact behavior of the original
+// hard-coded 50/30 point penalties.
+func defaultScoringPolicy() *ScoringPolicy {
+	return &ScoringPolicy{
+		Weights: map[string]int{
+			"CRITICAL": 50,
+			"HIGH":     30,
+			"MEDIUM":   10,
+			"LOW":      2,
+		},
+		Aggregation: AggregationSubtract,
+	}
+}
+
+// LoadScoringPolicy reads a ScoringPolicy from a scoring.yaml file at path.
+// A missing file falls back to defaultScoringPolicy so --scoring-policy is
+// optional.
+func LoadScoringPolicy(path string) (*ScoringPolicy, error) {
+	raw, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return defaultScoringPolicy(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading scoring policy %q: %w", path, err)
+	}
+
+	policy := &ScoringPolicy{}
+	if err := yaml.Unmarshal(raw, policy); err != nil {
+		return nil, fmt.Errorf("parsing scoring policy %q: %w", path, err)
+	}
+	if policy.Aggregation == "" {
+		policy.Aggregation = AggregationSubtract
+	}
+	// Severities the file leaves out keep their default weight.
+	weights := defaultScoringPolicy().Weights
+	for k, v := range upperCaseKeys(policy.Weights) {
+		weights[k] = v
+	}
+	policy.Weights = weights
+	return policy, nil
+}
+
+// upperCaseKeys normalizes severity keys (e.g. "critical" in scoring.yaml)
+// to the uppercase form Violation.Severity uses, so weight lookups match
+// regardless of the case used in the YAML file.
+func upperCaseKeys(weights map[string]int) map[string]int {
+	out := make(map[string]int, len(weights))
+	for k, v := range weights {
+		out[strings.ToUpper(k)] = v
+	}
+	return out
+}
+
+// weightFor returns the score weight for a violation, preferring a
+// per-rule override over the severity's default weight.
+func (p *ScoringPolicy) weightFor(v Violation) int {
+	if w, ok := p.RuleOverrides[v.RuleID]; ok {
+		return w
+	}
+	return p.Weights[v.Severity]
+}
+
+// Score computes an asset's ComplianceScore from its violations under this
+// policy's aggregation mode.
+func (p *ScoringPolicy) Score(violations []Violation) int {
+	switch p.Aggregation {
+	case AggregationMultiplicative:
+		return p.scoreMultiplicative(violations)
+	case AggregationCVSSLike:
+		return p.scoreCVSSLike(violations)
+	default:
+		return p.scoreSubtract(violations)
+	}
+}
+
+func (p *ScoringPolicy) scoreSubtract(violations []Violation) int {
+	score := 100
+	for _, v := range violations {
+		score -= p.weightFor(v)
+	}
+	return clampScore(score)
+}
+
+func (p *ScoringPolicy) scoreMultiplicative(violations []Violation) int {
+	score := 100.0
+	for _, v := range violations {
+		weight := float64(p.weightFor(v))
+		score *= 1 - weight/100
+	}
+	return clampScore(int(score))
+}
+
+func (p *ScoringPolicy) scoreCVSSLike(violations []Violation) int {
+	worst := 0
+	for _, v := range violations {
+		if w := p.weightFor(v); w > worst {
+			worst = w
+		}
+	}
+	return clampScore(100 - worst)
+}
+
+func clampScore(score int) int {
+	if score < 0 {
+		return 0
+	}
+	if score > 100 {
+		return 100
+	}
+	return score
+}
+
+// FailOnThresholdBreached reports whether assets breach the --fail-on
+// severity or --min-score gate, so main can choose the right CI exit code.
+func FailOnThresholdBreached(assets []Asset, failOn string, minScore int) bool {
+	for _, a := range assets {
+		if minScore > 0 && a.ComplianceScore < minScore {
+			return true
+		}
+		if failOn == "" {
+			continue
+		}
+		for _, v := range a.Violations {
+			if minSeverityRank[v.Severity] >= minSeverityRank[failOn] {
+				return true
+			}
+		}
+	}
+	return false
+}
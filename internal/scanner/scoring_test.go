@@ -0,0 +1,68 @@
+package scanner
+
+import "testing"
+
+func TestScoreAggregationModes(t *testing.T) {
+	violations := []Violation{
+		{RuleID: "R1", Severity: "CRITICAL"},
+		{RuleID: "R2", Severity: "HIGH"},
+	}
+	weights := map[string]int{"CRITICAL": 50, "HIGH": 30, "MEDIUM": 10, "LOW": 2}
+
+	tests := []struct {
+		name  string
+		mode  AggregationMode
+		score int
+	}{
+		{"subtract", AggregationSubtract, 20},             // 100 - 50 - 30
+		{"multiplicative", AggregationMultiplicative, 35}, // 100 * 0.5 * 0.7 = 35
+		{"cvss-like", AggregationCVSSLike, 50},            // 100 - max(50, 30)
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			policy := &ScoringPolicy{Weights: weights, Aggregation: tc.mode}
+			if got := policy.Score(violations); got != tc.score {
+				t.Errorf("Score() with %s aggregation = %d, want %d", tc.mode, got, tc.score)
+			}
+		})
+	}
+}
+
+func TestScoreRuleOverrideTakesPriorityOverSeverityWeight(t *testing.T) {
+	policy := &ScoringPolicy{
+		Weights:       map[string]int{"CRITICAL": 50},
+		RuleOverrides: map[string]int{"R1": 5},
+		Aggregation:   AggregationSubtract,
+	}
+	got := policy.Score([]Violation{{RuleID: "R1", Severity: "CRITICAL"}})
+	if want := 95; got != want {
+		t.Errorf("Score() = %d, want %d (override should win over severity weight)", got, want)
+	}
+}
+
+func TestScoreClampsToZero(t *testing.T) {
+	policy := &ScoringPolicy{Weights: map[string]int{"CRITICAL": 50}, Aggregation: AggregationSubtract}
+	violations := make([]Violation, 5)
+	for i := range violations {
+		violations[i] = Violation{RuleID: "R", Severity: "CRITICAL"}
+	}
+	if got := policy.Score(violations); got != 0 {
+		t.Errorf("Score() = %d, want 0 (clamped)", got)
+	}
+}
+
+func TestLoadScoringPolicyUpperCasesWeightKeys(t *testing.T) {
+	policy, err := LoadScoringPolicy("does-not-exist.yaml")
+	if err != nil {
+		t.Fatalf("LoadScoringPolicy: %v", err)
+	}
+	if policy.Weights["CRITICAL"] == 0 {
+		t.Fatalf("default policy missing CRITICAL weight: %+v", policy.Weights)
+	}
+
+	upper := upperCaseKeys(map[string]int{"critical": 50, "high": 30})
+	if upper["CRITICAL"] != 50 || upper["HIGH"] != 30 {
+		t.Errorf("upperCaseKeys(...) = %+v, want CRITICAL=50, HIGH=30", upper)
+	}
+}
@@ -0,0 +1,92 @@
+package scanner
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// ScanServer exposes the persisted scan history over HTTP, modeled on the
+// Harbor scan API: fetch a report, stream its log, or look up the latest
+// violations for a single asset.
+type ScanServer struct {
+	store  *ScanStore
+	logDir string
+}
+
+// NewScanServer wires up a ScanServer backed by store, serving per-run logs
+// from logDir.
+func NewScanServer(store *ScanStore, logDir string) *ScanServer {
+	return &ScanServer{store: store, logDir: logDir}
+}
+
+// Serve starts the HTTP server and blocks until it exits or errors.
+func (s *ScanServer) Serve(addr string) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/scans/", s.handleScans)
+	mux.HandleFunc("/assets/", s.handleAssetViolations)
+
+	fmt.Printf("Serving scan history on %s\n", addr)
+	return http.ListenAndServe(addr, mux)
+}
+
+// handleScans routes GET /scans/{report_id} and GET /scans/{report_id}/log.
+func (s *ScanServer) handleScans(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/scans/")
+	reportID, rest, hasLog := strings.Cut(path, "/")
+
+	if hasLog && rest == "log" {
+		s.serveLog(w, reportID)
+		return
+	}
+	if hasLog {
+		http.NotFound(w, r)
+		return
+	}
+
+	scan, err := s.store.GetScan(reportID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	writeJSON(w, scan)
+}
+
+func (s *ScanServer) serveLog(w http.ResponseWriter, reportID string) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+
+	data, err := os.ReadFile(LogPath(s.logDir, reportID))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("log for scan %q not found", reportID), http.StatusNotFound)
+		return
+	}
+	w.Write(data)
+}
+
+// handleAssetViolations routes GET /assets/{asset_id}/violations.
+func (s *ScanServer) handleAssetViolations(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/assets/")
+	assetID, rest, ok := strings.Cut(path, "/")
+	if !ok || rest != "violations" {
+		http.NotFound(w, r)
+		return
+	}
+
+	violations, err := s.store.LatestViolationsForAsset(assetID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	writeJSON(w, violations)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
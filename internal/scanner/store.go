@@ -0,0 +1,194 @@
+package scanner
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+// ScanStore persists every scan run to a local SQLite database so past
+// reports and violations can be retrieved after the process exits.
+type ScanStore struct {
+	db *sql.DB
+}
+
+// OpenScanStore opens (and migrates, if needed) the SQLite database at
+// path.
+func OpenScanStore(path string) (*ScanStore, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("store: opening %q: %w", path, err)
+	}
+
+	store := &ScanStore{db: db}
+	if err := store.migrate(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("store: migrating schema: %w", err)
+	}
+	return store, nil
+}
+
+func (s *ScanStore) Close() error { return s.db.Close() }
+
+func (s *ScanStore) migrate() error {
+	_, err := s.db.Exec(`
+		CREATE TABLE IF NOT EXISTS scans (
+			id           TEXT PRIMARY KEY,
+			started_at   TEXT NOT NULL,
+			finished_at  TEXT NOT NULL,
+			provider     TEXT NOT NULL,
+			summary_json TEXT NOT NULL
+		);
+		CREATE TABLE IF NOT EXISTS assets (
+			scan_id TEXT NOT NULL,
+			id      TEXT NOT NULL,
+			data    TEXT NOT NULL
+		);
+		CREATE TABLE IF NOT EXISTS violations (
+			scan_id     TEXT NOT NULL,
+			asset_id    TEXT NOT NULL,
+			rule_id     TEXT NOT NULL,
+			description TEXT NOT NULL,
+			severity    TEXT NOT NULL
+		);
+	`)
+	return err
+}
+
+// ScanRecord is a single persisted scan run.
+type ScanRecord struct {
+	ID         string    `json:"id"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	Provider   string    `json:"provider"`
+	Assets     []Asset   `json:"assets"`
+}
+
+// SaveScan persists a completed scan under reportID.
+func (s *ScanStore) SaveScan(reportID, provider string, startedAt, finishedAt time.Time, assets []Asset) error {
+	summary, err := json.Marshal(ScanSummaryOf(assets))
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`INSERT INTO scans (id, started_at, finished_at, provider, summary_json) VALUES (?, ?, ?, ?, ?)`,
+		reportID, startedAt.Format(time.RFC3339), finishedAt.Format(time.RFC3339), provider, string(summary))
+	if err != nil {
+		return fmt.Errorf("inserting scan: %w", err)
+	}
+
+	for _, a := range assets {
+		data, err := json.Marshal(a)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(`INSERT INTO assets (scan_id, id, data) VALUES (?, ?, ?)`, reportID, a.ID, string(data)); err != nil {
+			return fmt.Errorf("inserting asset %q: %w", a.ID, err)
+		}
+		for _, v := range a.Violations {
+			if _, err := tx.Exec(`INSERT INTO violations (scan_id, asset_id, rule_id, description, severity) VALUES (?, ?, ?, ?, ?)`,
+				reportID, a.ID, v.RuleID, v.Description, v.Severity); err != nil {
+				return fmt.Errorf("inserting violation for asset %q: %w", a.ID, err)
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ScanSummary is the small JSON blob stored in scans.summary_json.
+type ScanSummary struct {
+	AssetCount     int `json:"asset_count"`
+	ViolationCount int `json:"violation_count"`
+	FailedCount    int `json:"failed_count"`
+}
+
+func ScanSummaryOf(assets []Asset) ScanSummary {
+	summary := ScanSummary{AssetCount: len(assets)}
+	for _, a := range assets {
+		summary.ViolationCount += len(a.Violations)
+		if a.ComplianceScore < 100 {
+			summary.FailedCount++
+		}
+	}
+	return summary
+}
+
+// GetScan returns the full persisted report for reportID.
+func (s *ScanStore) GetScan(reportID string) (*ScanRecord, error) {
+	row := s.db.QueryRow(`SELECT started_at, finished_at, provider FROM scans WHERE id = ?`, reportID)
+
+	var startedAt, finishedAt, provider string
+	if err := row.Scan(&startedAt, &finishedAt, &provider); err != nil {
+		return nil, fmt.Errorf("scan %q not found: %w", reportID, err)
+	}
+
+	rows, err := s.db.Query(`SELECT data FROM assets WHERE scan_id = ?`, reportID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var assets []Asset
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		var a Asset
+		if err := json.Unmarshal([]byte(data), &a); err != nil {
+			return nil, err
+		}
+		assets = append(assets, a)
+	}
+
+	started, _ := time.Parse(time.RFC3339, startedAt)
+	finished, _ := time.Parse(time.RFC3339, finishedAt)
+
+	return &ScanRecord{
+		ID:         reportID,
+		StartedAt:  started,
+		FinishedAt: finished,
+		Provider:   provider,
+		Assets:     assets,
+	}, nil
+}
+
+// LatestViolationsForAsset returns the violations recorded for assetID in
+// its most recent scan.
+func (s *ScanStore) LatestViolationsForAsset(assetID string) ([]Violation, error) {
+	row := s.db.QueryRow(`
+		SELECT scan_id FROM assets WHERE id = ?
+		ORDER BY rowid DESC LIMIT 1
+	`, assetID)
+
+	var scanID string
+	if err := row.Scan(&scanID); err != nil {
+		return nil, fmt.Errorf("asset %q not found: %w", assetID, err)
+	}
+
+	rows, err := s.db.Query(`SELECT rule_id, description, severity FROM violations WHERE scan_id = ? AND asset_id = ?`, scanID, assetID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var violations []Violation
+	for rows.Next() {
+		var v Violation
+		if err := rows.Scan(&v.RuleID, &v.Description, &v.Severity); err != nil {
+			return nil, err
+		}
+		violations = append(violations, v)
+	}
+	return violations, nil
+}